@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver // import "opentelemetry.io/collector/receiver/hostmetricsreceiver"
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal"
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/perfcounters"
+)
+
+// ScopeMode selects where a scraper reads its data from.
+type ScopeMode string
+
+const (
+	// ScopeHost reports metrics for the whole host, as seen by the kernel. This is
+	// the default and matches the receiver's historical behavior.
+	ScopeHost ScopeMode = "host"
+	// ScopeCgroup reports metrics scoped to the cgroup v2 slice the receiver is
+	// running in, which is usually what's wanted when the receiver itself runs
+	// inside the container/pod being monitored.
+	ScopeCgroup ScopeMode = "cgroup"
+)
+
+// Config relates to scraping hardware and host-level metrics and logs.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+
+	// MetadataCollectionInterval controls how often the host entities receiver
+	// re-scrapes host metadata.
+	MetadataCollectionInterval time.Duration `mapstructure:"metadata_collection_interval"`
+
+	// RootPath, when non-empty, is prepended to the /proc/self/cgroup and
+	// /sys/fs/cgroup paths read in ScopeCgroup mode, for use when this receiver
+	// runs in a container that bind-mounts the host's root filesystem elsewhere
+	// (e.g. "/hostfs"). The receiver does not pass it on to the scrapers.
+	RootPath string `mapstructure:"root_path"`
+
+	// ScopeMode selects whether scrapers that support it read host-wide data or
+	// data scoped to the cgroup v2 slice the receiver itself runs in. Defaults to
+	// ScopeHost. Only the "load" scraper supports it; the "cpu", "memory" and
+	// "paging" scrapers report host-wide data in either mode.
+	ScopeMode ScopeMode `mapstructure:"scope"`
+
+	// Scrapers is a map of scraper name to its individual config.
+	Scrapers map[string]internal.Config `mapstructure:"-"`
+
+	// ExtraCounters lists additional raw Windows performance counters. Validate
+	// checks that each entry has a path and a metric name; the receiver does not
+	// add these counters to a PDH query and emits no metrics for them.
+	ExtraCounters []perfcounters.ExtraCounter `mapstructure:"extra_counters"`
+}
+
+// Validate checks for invalid receiver configuration.
+func (cfg *Config) Validate() error {
+	switch cfg.ScopeMode {
+	case "", ScopeHost, ScopeCgroup:
+	default:
+		return fmt.Errorf("scope must be %q or %q, got %q", ScopeHost, ScopeCgroup, cfg.ScopeMode)
+	}
+
+	return perfcounters.ValidateExtraCounters(cfg.ExtraCounters)
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate_ScopeMode(t *testing.T) {
+	for _, scope := range []ScopeMode{"", ScopeHost, ScopeCgroup} {
+		cfg := &Config{ScopeMode: scope}
+		assert.NoError(t, cfg.Validate())
+	}
+}
+
+func TestConfigValidate_InvalidScopeMode(t *testing.T) {
+	cfg := &Config{ScopeMode: "cgrp"}
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cgrp")
+}
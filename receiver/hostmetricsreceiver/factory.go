@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/scraper"
 
 	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal"
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
 	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/metadata"
 	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
@@ -60,14 +61,6 @@ func NewFactory() receiver.Factory {
 		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
-func getScraperFactory(key string) (internal.ScraperFactory, bool) {
-	if factory, ok := scraperFactories[key]; ok {
-		return factory, true
-	}
-
-	return nil, false
-}
-
 // createDefaultConfig creates the default configuration for receiver.
 func createDefaultConfig() component.Config {
 	return &Config{
@@ -82,10 +75,32 @@ func createMetricsReceiver(
 	set receiver.Settings,
 	cfg component.Config,
 	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	return newMetricsReceiver(ctx, set, cfg, consumer, snapshotScraperFactories())
+}
+
+// newMetricsReceiver builds a metrics receiver using the supplied scraper
+// factories, rather than unconditionally consulting the package-level registry.
+// This lets NewFactoryWithScrapers produce a factory with its own independent set
+// of available scrapers.
+func newMetricsReceiver(
+	ctx context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	consumer consumer.Metrics,
+	factories map[string]internal.ScraperFactory,
 ) (receiver.Metrics, error) {
 	oCfg := cfg.(*Config)
 
-	addScraperOptions, err := createAddScraperOptions(ctx, set, oCfg, scraperFactories)
+	if oCfg.ScopeMode == ScopeCgroup {
+		reader, err := cgroups.NewReader(oCfg.RootPath)
+		if err != nil {
+			return nil, fmt.Errorf("scope %q requires a cgroup v2 hierarchy: %w", ScopeCgroup, err)
+		}
+		ctx = cgroups.NewContext(ctx, reader)
+	}
+
+	addScraperOptions, err := createAddScraperOptions(ctx, set, oCfg, factories)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cgroups reads accounting data exposed by the cgroup v2 filesystem, so
+// that scrapers can report metrics scoped to the cgroup the receiver itself runs
+// in rather than the whole host.
+package cgroups // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	defaultCgroupMount = "/sys/fs/cgroup"
+	selfCgroupFile     = "/proc/self/cgroup"
+)
+
+// Reader reads cgroup v2 accounting files for a single cgroup.
+type Reader struct {
+	// cgroupPath is the absolute path to the cgroup v2 directory to read from,
+	// e.g. "/sys/fs/cgroup/system.slice/myapp.service".
+	cgroupPath string
+}
+
+// NewReader returns a Reader for the cgroup v2 slice the calling process belongs
+// to. rootPath, if non-empty, is prepended to the well-known procfs/sysfs paths,
+// for use when the receiver's view of the host is bind-mounted elsewhere (e.g.
+// "/hostfs").
+func NewReader(rootPath string) (*Reader, error) {
+	slice, err := ownCgroupSlice(filepath.Join(rootPath, selfCgroupFile))
+	if err != nil {
+		return nil, fmt.Errorf("determine own cgroup: %w", err)
+	}
+
+	return &Reader{cgroupPath: filepath.Join(rootPath, defaultCgroupMount, slice)}, nil
+}
+
+// ownCgroupSlice parses a /proc/<pid>/cgroup file and returns the unified (v2)
+// hierarchy path for the process, relative to the cgroup v2 mount point.
+func ownCgroupSlice(cgroupFile string) (string, error) {
+	f, err := os.Open(cgroupFile)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// cgroup v2 entries have the form "0::/path/to/slice".
+		line := scanner.Text()
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) == 3 && parts[0] == "0" && parts[1] == "" {
+			return parts[2], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("no cgroup v2 entry found in %s", cgroupFile)
+}
+
+// path joins name onto the cgroup's directory.
+func (r *Reader) path(name string) string {
+	return filepath.Join(r.cgroupPath, name)
+}
+
+// readKeyedUint64s reads a file made up of "key value" lines, such as cpu.stat or
+// memory.stat, into a map.
+func readKeyedUint64s(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	out := make(map[string]uint64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		var v uint64
+		if _, err := fmt.Sscanf(fields[1], "%d", &v); err != nil {
+			continue
+		}
+		out[fields[0]] = v
+	}
+	return out, scanner.Err()
+}
+
+// readSingleUint64 reads a file containing a single integer value, such as
+// memory.current. It also accepts the literal value "max", returned as false.
+func readSingleUint64(path string) (value uint64, ok bool, err error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false, err
+	}
+
+	s := strings.TrimSpace(string(b))
+	if s == "max" {
+		return 0, false, nil
+	}
+
+	if _, err := fmt.Sscanf(s, "%d", &value); err != nil {
+		return 0, false, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	return value, true, nil
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeCgroupRoot lays out a minimal cgroup v2 tree under a temp directory:
+// <root>/proc/self/cgroup pointing at <root>/sys/fs/cgroup/<slice>, and returns
+// both the root and the slice directory so tests can populate accounting files.
+func newFakeCgroupRoot(t *testing.T, slice string) (root, cgroupDir string) {
+	t.Helper()
+
+	root = t.TempDir()
+
+	procSelf := filepath.Join(root, "proc", "self")
+	require.NoError(t, os.MkdirAll(procSelf, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procSelf, "cgroup"), []byte("0::"+slice+"\n"), 0o600))
+
+	cgroupDir = filepath.Join(root, "sys", "fs", "cgroup", slice)
+	require.NoError(t, os.MkdirAll(cgroupDir, 0o755))
+
+	return root, cgroupDir
+}
+
+func TestNewReader(t *testing.T) {
+	root, cgroupDir := newFakeCgroupRoot(t, "testslice")
+
+	r, err := NewReader(root)
+	require.NoError(t, err)
+	assert.Equal(t, cgroupDir, r.cgroupPath)
+}
+
+func TestNewReader_NoCgroupFile(t *testing.T) {
+	_, err := NewReader(t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestOwnCgroupSlice(t *testing.T) {
+	dir := t.TempDir()
+	cgroupFile := filepath.Join(dir, "cgroup")
+
+	t.Run("v2 entry", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(cgroupFile, []byte("0::/user.slice/user-1000.slice\n"), 0o600))
+		slice, err := ownCgroupSlice(cgroupFile)
+		require.NoError(t, err)
+		assert.Equal(t, "/user.slice/user-1000.slice", slice)
+	})
+
+	t.Run("no v2 entry", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(cgroupFile, []byte("1:cpu,cpuacct:/\n"), 0o600))
+		_, err := ownCgroupSlice(cgroupFile)
+		assert.Error(t, err)
+	})
+}
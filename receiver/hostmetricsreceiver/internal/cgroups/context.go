@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
+
+import "context"
+
+type readerKey struct{}
+
+// NewContext returns a copy of ctx carrying r, so that scrapers created further
+// down the call chain can recover it via FromContext and read cgroup-scoped data
+// instead of host-wide data.
+func NewContext(ctx context.Context, r *Reader) context.Context {
+	return context.WithValue(ctx, readerKey{}, r)
+}
+
+// FromContext returns the Reader previously attached with NewContext, if any. ok
+// is false when the receiver is running in ScopeHost mode.
+func FromContext(ctx context.Context) (r *Reader, ok bool) {
+	r, ok = ctx.Value(readerKey{}).(*Reader)
+	return r, ok
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
+
+import "fmt"
+
+// CPUStat is the decoded content of a cgroup's cpu.stat file.
+type CPUStat struct {
+	UsageUsec     uint64
+	UserUsec      uint64
+	SystemUsec    uint64
+	NrPeriods     uint64
+	NrThrottled   uint64
+	ThrottledUsec uint64
+}
+
+// CPUStat reads and parses the cgroup's cpu.stat file.
+func (r *Reader) CPUStat() (CPUStat, error) {
+	kvs, err := readKeyedUint64s(r.path("cpu.stat"))
+	if err != nil {
+		return CPUStat{}, fmt.Errorf("read cpu.stat: %w", err)
+	}
+
+	return CPUStat{
+		UsageUsec:     kvs["usage_usec"],
+		UserUsec:      kvs["user_usec"],
+		SystemUsec:    kvs["system_usec"],
+		NrPeriods:     kvs["nr_periods"],
+		NrThrottled:   kvs["nr_throttled"],
+		ThrottledUsec: kvs["throttled_usec"],
+	}, nil
+}
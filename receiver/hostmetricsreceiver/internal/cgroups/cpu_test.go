@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaderCPUStat(t *testing.T) {
+	dir := t.TempDir()
+	content := "usage_usec 100\n" +
+		"user_usec 60\n" +
+		"system_usec 40\n" +
+		"nr_periods 5\n" +
+		"nr_throttled 1\n" +
+		"throttled_usec 20\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cpu.stat"), []byte(content), 0o600))
+
+	r := &Reader{cgroupPath: dir}
+
+	stat, err := r.CPUStat()
+	require.NoError(t, err)
+
+	assert.Equal(t, CPUStat{
+		UsageUsec:     100,
+		UserUsec:      60,
+		SystemUsec:    40,
+		NrPeriods:     5,
+		NrThrottled:   1,
+		ThrottledUsec: 20,
+	}, stat)
+}
+
+func TestReaderCPUStat_MissingFile(t *testing.T) {
+	r := &Reader{cgroupPath: t.TempDir()}
+
+	_, err := r.CPUStat()
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// IOStat holds the accounting fields reported for a single device in a cgroup's
+// io.stat file.
+type IOStat struct {
+	Device         string
+	RBytes, WBytes uint64
+	RIOs, WIOs     uint64
+	DBytes, DIOs   uint64
+}
+
+// IOStat reads and parses the cgroup's io.stat file, which has one line per
+// device of the form "<major>:<minor> rbytes=.. wbytes=.. rios=.. wios=.. ...".
+func (r *Reader) IOStat() ([]IOStat, error) {
+	f, err := os.Open(r.path("io.stat"))
+	if err != nil {
+		return nil, fmt.Errorf("read io.stat: %w", err)
+	}
+	defer f.Close()
+
+	var stats []IOStat
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		stat := IOStat{Device: fields[0]}
+		for _, kv := range fields[1:] {
+			k, v, ok := strings.Cut(kv, "=")
+			if !ok {
+				continue
+			}
+			var n uint64
+			if _, err := fmt.Sscanf(v, "%d", &n); err != nil {
+				continue
+			}
+			switch k {
+			case "rbytes":
+				stat.RBytes = n
+			case "wbytes":
+				stat.WBytes = n
+			case "rios":
+				stat.RIOs = n
+			case "wios":
+				stat.WIOs = n
+			case "dbytes":
+				stat.DBytes = n
+			case "dios":
+				stat.DIOs = n
+			}
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats, scanner.Err()
+}
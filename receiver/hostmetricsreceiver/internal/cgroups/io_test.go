@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaderIOStat(t *testing.T) {
+	dir := t.TempDir()
+	content := "8:0 rbytes=1024 wbytes=2048 rios=4 wios=8 dbytes=0 dios=0\n" +
+		"8:16 rbytes=512 wbytes=256 rios=2 wios=1 dbytes=0 dios=0\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "io.stat"), []byte(content), 0o600))
+
+	r := &Reader{cgroupPath: dir}
+
+	stats, err := r.IOStat()
+	require.NoError(t, err)
+
+	assert.Equal(t, []IOStat{
+		{Device: "8:0", RBytes: 1024, WBytes: 2048, RIOs: 4, WIOs: 8},
+		{Device: "8:16", RBytes: 512, WBytes: 256, RIOs: 2, WIOs: 1},
+	}, stats)
+}
+
+func TestReaderIOStat_MissingFile(t *testing.T) {
+	r := &Reader{cgroupPath: t.TempDir()}
+
+	_, err := r.IOStat()
+	assert.Error(t, err)
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
+
+import "fmt"
+
+// MemoryStat is the decoded content of a cgroup's memory accounting files.
+type MemoryStat struct {
+	// Current is the value of memory.current, in bytes.
+	Current uint64
+	// Max is the value of memory.max, in bytes. HasMax is false when the limit is
+	// "max" (i.e. unlimited).
+	Max    uint64
+	HasMax bool
+	// Detail holds the key/value pairs from memory.stat (e.g. "file", "anon",
+	// "kernel_stack"), in bytes.
+	Detail map[string]uint64
+}
+
+// MemoryStat reads and parses the cgroup's memory.current, memory.max and
+// memory.stat files.
+func (r *Reader) MemoryStat() (MemoryStat, error) {
+	current, _, err := readSingleUint64(r.path("memory.current"))
+	if err != nil {
+		return MemoryStat{}, fmt.Errorf("read memory.current: %w", err)
+	}
+
+	max, hasMax, err := readSingleUint64(r.path("memory.max"))
+	if err != nil {
+		return MemoryStat{}, fmt.Errorf("read memory.max: %w", err)
+	}
+
+	detail, err := readKeyedUint64s(r.path("memory.stat"))
+	if err != nil {
+		return MemoryStat{}, fmt.Errorf("read memory.stat: %w", err)
+	}
+
+	return MemoryStat{Current: current, Max: max, HasMax: hasMax, Detail: detail}, nil
+}
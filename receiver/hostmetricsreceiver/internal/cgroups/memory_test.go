@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaderMemoryStat(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.current"), []byte("1048576\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.max"), []byte("2097152\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.stat"), []byte("file 524288\nanon 262144\n"), 0o600))
+
+	r := &Reader{cgroupPath: dir}
+
+	stat, err := r.MemoryStat()
+	require.NoError(t, err)
+
+	assert.Equal(t, MemoryStat{
+		Current: 1048576,
+		Max:     2097152,
+		HasMax:  true,
+		Detail:  map[string]uint64{"file": 524288, "anon": 262144},
+	}, stat)
+}
+
+func TestReaderMemoryStat_Unlimited(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.current"), []byte("1048576\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.max"), []byte("max\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.stat"), []byte(""), 0o600))
+
+	r := &Reader{cgroupPath: dir}
+
+	stat, err := r.MemoryStat()
+	require.NoError(t, err)
+
+	assert.False(t, stat.HasMax)
+	assert.Zero(t, stat.Max)
+}
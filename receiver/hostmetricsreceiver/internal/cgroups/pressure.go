@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// PressureStall is the decoded content of one line ("some" or "full") of a PSI
+// pressure file, e.g. cpu.pressure or memory.pressure.
+type PressureStall struct {
+	Avg10, Avg60, Avg300 float64
+	// Total is the total stall time in microseconds since boot.
+	Total uint64
+}
+
+// Pressure is the decoded content of a PSI pressure file. Some and Full hold the
+// "some" and "full" lines; either is the zero value when the file has no such
+// line (older kernels omit "full" from cpu.pressure).
+type Pressure struct {
+	Some PressureStall
+	Full PressureStall
+}
+
+// CPUPressure reads and parses cpu.pressure.
+func (r *Reader) CPUPressure() (Pressure, error) {
+	return r.readPressure("cpu.pressure")
+}
+
+// MemoryPressure reads and parses memory.pressure.
+func (r *Reader) MemoryPressure() (Pressure, error) {
+	return r.readPressure("memory.pressure")
+}
+
+// IOPressure reads and parses io.pressure.
+func (r *Reader) IOPressure() (Pressure, error) {
+	return r.readPressure("io.pressure")
+}
+
+func (r *Reader) readPressure(name string) (Pressure, error) {
+	f, err := os.Open(r.path(name))
+	if err != nil {
+		return Pressure{}, fmt.Errorf("read %s: %w", name, err)
+	}
+	defer f.Close()
+
+	var p Pressure
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		stall, err := parsePressureStall(fields[1:])
+		if err != nil {
+			return Pressure{}, fmt.Errorf("parse %s line %q: %w", name, scanner.Text(), err)
+		}
+
+		switch fields[0] {
+		case "some":
+			p.Some = stall
+		case "full":
+			p.Full = stall
+		}
+	}
+
+	return p, scanner.Err()
+}
+
+// parsePressureStall parses the "avg10=.. avg60=.. avg300=.. total=.." fields
+// that follow the "some"/"full" keyword on a PSI pressure line.
+func parsePressureStall(fields []string) (PressureStall, error) {
+	var stall PressureStall
+	for _, kv := range fields {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "avg10":
+			_, _ = fmt.Sscanf(v, "%f", &stall.Avg10)
+		case "avg60":
+			_, _ = fmt.Sscanf(v, "%f", &stall.Avg60)
+		case "avg300":
+			_, _ = fmt.Sscanf(v, "%f", &stall.Avg300)
+		case "total":
+			_, _ = fmt.Sscanf(v, "%d", &stall.Total)
+		}
+	}
+	return stall, nil
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cgroups
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaderCPUPressure(t *testing.T) {
+	dir := t.TempDir()
+	content := "some avg10=1.50 avg60=2.25 avg300=0.10 total=123456\n" +
+		"full avg10=0.00 avg60=0.00 avg300=0.00 total=0\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cpu.pressure"), []byte(content), 0o600))
+
+	r := &Reader{cgroupPath: dir}
+
+	pressure, err := r.CPUPressure()
+	require.NoError(t, err)
+
+	assert.Equal(t, 1.50, pressure.Some.Avg10)
+	assert.Equal(t, 2.25, pressure.Some.Avg60)
+	assert.Equal(t, 0.10, pressure.Some.Avg300)
+	assert.Equal(t, uint64(123456), pressure.Some.Total)
+	assert.Equal(t, PressureStall{}, pressure.Full)
+}
@@ -0,0 +1,15 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package perfcounters provides a single, shared PDH (Performance Data Helper)
+// query that Windows scrapers add their counters to, instead of each scraper
+// opening its own PDH handle. A Query is opened once when the receiver starts,
+// collected once per scrape, and the resulting values are dispatched to
+// whichever scrapers registered a Consumer for a given counter.
+//
+// pagingscraper (see scraper_windows.go) is wired to this package end-to-end:
+// it adds its counter via AddCounter, and picks up a SharedQueryExtension from
+// component.Host when one is configured instead of always opening its own
+// Query. cpuscraper, diskscraper, networkscraper and processscraper do not use
+// this package.
+package perfcounters // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/perfcounters"
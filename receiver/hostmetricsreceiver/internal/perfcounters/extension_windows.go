@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package perfcounters // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/perfcounters"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+)
+
+// SharedQueryExtension lets distributions open a single perfcounters.Query and
+// share it across every hostmetricsreceiver instance in the pipeline, instead of
+// each receiver opening its own PDH query. Register it under a component ID and
+// enable it in the service's extensions; QueryFromHost finds it there.
+type SharedQueryExtension struct {
+	query *Query
+}
+
+var _ extension.Extension = (*SharedQueryExtension)(nil)
+
+// NewSharedQueryExtension creates an extension wrapping a freshly opened Query.
+func NewSharedQueryExtension() (*SharedQueryExtension, error) {
+	q, err := NewQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedQueryExtension{query: q}, nil
+}
+
+// Query returns the shared PDH query owned by this extension.
+func (e *SharedQueryExtension) Query() *Query {
+	return e.query
+}
+
+func (e *SharedQueryExtension) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (e *SharedQueryExtension) Shutdown(context.Context) error {
+	return e.query.Close()
+}
+
+// QueryFromHost looks through host's configured extensions for a
+// SharedQueryExtension and returns the Query of the first one found. If none is
+// configured, the caller should fall back to opening its own Query via NewQuery.
+func QueryFromHost(host component.Host) (*Query, bool) {
+	for _, ext := range host.GetExtensions() {
+		if shared, ok := ext.(*SharedQueryExtension); ok {
+			return shared.Query(), true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package perfcounters // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/perfcounters"
+
+import "fmt"
+
+// ExtraCounterConsumer adapts an ExtraCounter's collected values into a metric
+// dispatch callback, so that scrapers don't need their own Consumer type just to
+// handle user-configured extra_counters.
+type ExtraCounterConsumer struct {
+	Counter  ExtraCounter
+	OnValues func(metricName, attribute string, values map[string]float64)
+}
+
+// Consume implements Consumer.
+func (c ExtraCounterConsumer) Consume(values map[string]float64) {
+	c.OnValues(c.Counter.MetricName, c.Counter.Attribute, values)
+}
+
+// ValidateExtraCounters checks that every configured extra counter has both a
+// path and a metric name, returning a descriptive error identifying the
+// offending entry otherwise.
+func ValidateExtraCounters(counters []ExtraCounter) error {
+	for i, c := range counters {
+		if c.Path == "" {
+			return fmt.Errorf("extra_counters[%d]: path must not be empty", i)
+		}
+		if c.MetricName == "" {
+			return fmt.Errorf("extra_counters[%d] (%s): metric_name must not be empty", i, c.Path)
+		}
+	}
+
+	return nil
+}
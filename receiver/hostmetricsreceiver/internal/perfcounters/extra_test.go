@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package perfcounters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateExtraCounters(t *testing.T) {
+	assert.NoError(t, ValidateExtraCounters(nil))
+
+	assert.NoError(t, ValidateExtraCounters([]ExtraCounter{
+		{Path: `\LogicalDisk(*)\Avg. Disk sec/Read`, MetricName: "disk.avg_read_seconds"},
+	}))
+
+	err := ValidateExtraCounters([]ExtraCounter{{MetricName: "missing_path"}})
+	assert.ErrorContains(t, err, "path")
+
+	err = ValidateExtraCounters([]ExtraCounter{{Path: `\Memory\Available Bytes`}})
+	assert.ErrorContains(t, err, "metric_name")
+}
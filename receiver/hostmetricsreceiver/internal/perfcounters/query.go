@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package perfcounters // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/perfcounters"
+
+// Consumer receives the values collected for the counters it registered via
+// Query.AddCounter, once per Query.Collect call.
+type Consumer interface {
+	// Consume is called once per scrape with the formatted values of the counter
+	// this Consumer registered, keyed by instance name ("" for single-instance
+	// counters such as "\Memory\Available Bytes", or e.g. a disk or NIC name for
+	// multi-instance counters such as "\LogicalDisk(*)\Avg. Disk sec/Read").
+	Consume(values map[string]float64)
+}
+
+// ExtraCounter describes one entry of the receiver's extra_counters config
+// option, letting users surface arbitrary raw PDH counters as metrics without
+// this package needing to know about them in advance.
+type ExtraCounter struct {
+	// Path is the PDH counter path, e.g. `\LogicalDisk(*)\Avg. Disk sec/Read`.
+	Path string `mapstructure:"path"`
+	// MetricName is the name of the metric emitted for this counter.
+	MetricName string `mapstructure:"metric_name"`
+	// Attribute, if non-empty, is the name of the attribute used to record the
+	// counter's instance (e.g. "device"); omitted entirely for single-instance
+	// counters.
+	Attribute string `mapstructure:"attribute"`
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package perfcounters // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/perfcounters"
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/third_party/telegraf/win_perf_counters"
+)
+
+// Query owns a single PDH query handle shared by every scraper that registers a
+// counter on it. Open it once at receiver start, call Collect once per scrape,
+// and Close it on shutdown.
+type Query struct {
+	mu sync.Mutex
+
+	query win_perf_counters.PerformanceQuery
+
+	// registrations are replayed in Collect, in registration order, so that two
+	// scrapers registering the same counter path don't race on which Consumer
+	// wins.
+	registrations []registration
+}
+
+type registration struct {
+	path     string
+	handle   win_perf_counters.PDH_HCOUNTER
+	consumer Consumer
+}
+
+// NewQuery opens a new PDH query. Callers should keep the returned Query for the
+// lifetime of the receiver and Close it on shutdown.
+func NewQuery() (*Query, error) {
+	q := &win_perf_counters.PerformanceQueryImpl{}
+	if err := q.Open(); err != nil {
+		return nil, fmt.Errorf("open PDH query: %w", err)
+	}
+
+	return &Query{query: q}, nil
+}
+
+// AddCounter adds path to the shared query and registers consumer to receive its
+// values on every subsequent Collect call. path may be a wildcard instance
+// counter (e.g. `\LogicalDisk(*)\Avg. Disk sec/Read`); Collect reports one value
+// per matched instance.
+func (q *Query) AddCounter(path string, consumer Consumer) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	handle, err := q.query.AddCounterToQuery(path)
+	if err != nil {
+		return fmt.Errorf("add counter %q: %w", path, err)
+	}
+
+	q.registrations = append(q.registrations, registration{path: path, handle: handle, consumer: consumer})
+	return nil
+}
+
+// Collect performs a single PDH data collection for every counter added via
+// AddCounter and dispatches the results to each counter's Consumer. It should be
+// called at most once per scrape interval, regardless of how many scrapers share
+// this Query.
+func (q *Query) Collect() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.query.CollectData(); err != nil {
+		return fmt.Errorf("collect PDH query data: %w", err)
+	}
+
+	for _, reg := range q.registrations {
+		values, err := q.query.GetFormattedCounterArrayDouble(reg.handle)
+		if err != nil {
+			return fmt.Errorf("read counter %q: %w", reg.path, err)
+		}
+
+		byInstance := make(map[string]float64, len(values))
+		for _, v := range values {
+			byInstance[v.InstanceName] = v.Value
+		}
+		reg.consumer.Consume(byInstance)
+	}
+
+	return nil
+}
+
+// Close releases the underlying PDH query handle.
+func (q *Query) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.query.Close()
+}
@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/collector/scraper"
 
 	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal"
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
 	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/loadscraper/internal/metadata"
 )
 
@@ -37,6 +38,15 @@ func (f *Factory) CreateMetricsScraper(
 	config internal.Config,
 ) (scraper.Metrics, error) {
 	cfg := config.(*Config)
+
+	// When the receiver is configured with scope: cgroup, report cgroup PSI
+	// pressure instead of the host-wide load average, which has no cgroup v2
+	// counterpart.
+	if reader, ok := cgroups.FromContext(ctx); ok {
+		s := newCgroupLoadScraper(reader)
+		return scraper.NewMetrics(s.scrape)
+	}
+
 	s := newLoadScraper(ctx, settings, cfg)
 
 	return scraper.NewMetrics(
@@ -44,4 +54,4 @@ func (f *Factory) CreateMetricsScraper(
 		scraper.WithStart(s.start),
 		scraper.WithShutdown(s.shutdown),
 	)
-}
\ No newline at end of file
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
+)
+
+// cgroupLoadScraper reports system.cpu.pressure and system.memory.pressure from
+// the cgroup's PSI ("some") stall percentages, for use when the receiver is
+// configured with scope: cgroup. gopsutil's host-wide load average has no
+// cgroup v2 equivalent, so this intentionally does not emit
+// system.cpu.load_average.*: those metrics mean "runnable process count" and
+// overloading them with a stall percentage would silently change their meaning
+// for any existing consumer or alert. system.memory.pressure is reported here
+// as well; memoryscraper does not read cgroup data.
+type cgroupLoadScraper struct {
+	reader *cgroups.Reader
+}
+
+func newCgroupLoadScraper(reader *cgroups.Reader) *cgroupLoadScraper {
+	return &cgroupLoadScraper{reader: reader}
+}
+
+func (s *cgroupLoadScraper) scrape(context.Context) (pmetric.Metrics, error) {
+	cpuPressure, err := s.reader.CPUPressure()
+	if err != nil {
+		return pmetric.Metrics{}, fmt.Errorf("read cgroup cpu pressure: %w", err)
+	}
+
+	memoryPressure, err := s.reader.MemoryPressure()
+	if err != nil {
+		return pmetric.Metrics{}, fmt.Errorf("read cgroup memory pressure: %w", err)
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	addPressureGauge(metrics, "system.cpu.pressure", now, cpuPressure.Some)
+	addPressureGauge(metrics, "system.memory.pressure", now, memoryPressure.Some)
+
+	return md, nil
+}
+
+// addPressureGauge adds a gauge metric with one data point per PSI averaging
+// window (10s/60s/300s), each carrying the fraction (0-1) of that window during
+// which at least one task was stalled.
+func addPressureGauge(metrics pmetric.MetricSlice, name string, now pcommon.Timestamp, stall cgroups.PressureStall) {
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	m.SetUnit("1")
+	gauge := m.SetEmptyGauge()
+
+	addPressureDataPoint(gauge, now, "10s", stall.Avg10)
+	addPressureDataPoint(gauge, now, "60s", stall.Avg60)
+	addPressureDataPoint(gauge, now, "300s", stall.Avg300)
+}
+
+func addPressureDataPoint(gauge pmetric.Gauge, now pcommon.Timestamp, window string, avgPercent float64) {
+	dp := gauge.DataPoints().AppendEmpty()
+	dp.SetTimestamp(now)
+	dp.Attributes().PutStr("window", window)
+	dp.SetDoubleValue(avgPercent / 100)
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/cgroups"
+)
+
+func TestCgroupLoadScraperScrape(t *testing.T) {
+	dir := t.TempDir()
+	cpuContent := "some avg10=1.50 avg60=2.25 avg300=0.10 total=123456\n" +
+		"full avg10=0.00 avg60=0.00 avg300=0.00 total=0\n"
+	memContent := "some avg10=5.00 avg60=4.00 avg300=3.00 total=654321\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cpu.pressure"), []byte(cpuContent), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory.pressure"), []byte(memContent), 0o600))
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "proc", "self"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "proc", "self", "cgroup"), []byte("0::/testslice\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "sys", "fs", "cgroup"), 0o755))
+	require.NoError(t, os.Rename(dir, filepath.Join(root, "sys", "fs", "cgroup", "testslice")))
+
+	reader, err := cgroups.NewReader(root)
+	require.NoError(t, err)
+
+	s := newCgroupLoadScraper(reader)
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	cpuMetric := metrics.At(0)
+	assert.Equal(t, "system.cpu.pressure", cpuMetric.Name())
+	assert.Equal(t, "1", cpuMetric.Unit())
+	require.Equal(t, 3, cpuMetric.Gauge().DataPoints().Len())
+	assert.Equal(t, 0.015, cpuMetric.Gauge().DataPoints().At(0).DoubleValue())
+
+	memMetric := metrics.At(1)
+	assert.Equal(t, "system.memory.pressure", memMetric.Name())
+	assert.Equal(t, 0.05, memMetric.Gauge().DataPoints().At(0).DoubleValue())
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagingscraper // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+
+// Config relates to paging metrics scraper.
+type Config struct{}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pagingscraper // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper"
+
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal"
+)
+
+// This file implements Factory for Paging scraper.
+
+const (
+	// TypeStr the value of "type" key in configuration.
+	TypeStr = "paging"
+)
+
+// Factory is the Factory for scraper.
+type Factory struct{}
+
+// CreateDefaultConfig creates the default configuration for the Scraper.
+func (f *Factory) CreateDefaultConfig() internal.Config {
+	return &Config{}
+}
+
+// CreateMetricsScraper creates a scraper based on provided config. On Windows the
+// scraper adds its counter to the PDH query of a configured
+// perfcounters.SharedQueryExtension, or opens its own query when none is
+// configured; on other platforms it reads swap statistics directly.
+func (f *Factory) CreateMetricsScraper(
+	ctx context.Context,
+	settings receiver.Settings,
+	config internal.Config,
+) (scraper.Metrics, error) {
+	cfg := config.(*Config)
+	s := newPagingScraper(ctx, settings, cfg)
+
+	return scraper.NewMetrics(
+		s.scrape,
+		scraper.WithStart(s.start),
+		scraper.WithShutdown(s.shutdown),
+	)
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package pagingscraper // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/mem"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// pagingScraper reports swap/page file utilization via gopsutil. There is no PDH
+// query to share outside Windows, so this platform doesn't use perfcounters.Query.
+type pagingScraper struct{}
+
+func newPagingScraper(context.Context, receiver.Settings, *Config) *pagingScraper {
+	return &pagingScraper{}
+}
+
+func (s *pagingScraper) start(context.Context, component.Host) error {
+	return nil
+}
+
+func (s *pagingScraper) shutdown(context.Context) error {
+	return nil
+}
+
+func (s *pagingScraper) scrape(context.Context) (pmetric.Metrics, error) {
+	swap, err := mem.SwapMemory()
+	if err != nil {
+		return pmetric.Metrics{}, fmt.Errorf("read swap memory stats: %w", err)
+	}
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	addPagingGauge(metrics, "system.paging.utilization", swap.UsedPercent/100)
+
+	return md, nil
+}
+
+func addPagingGauge(metrics pmetric.MetricSlice, name string, ratio float64) {
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	dp.SetDoubleValue(ratio)
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package pagingscraper // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/perfcounters"
+)
+
+const pctUsageCounter = `\Paging File(_Total)\% Usage`
+
+// pagingScraper reports paging file utilization from a PDH counter. When a
+// perfcounters.SharedQueryExtension is configured it adds its counter to that
+// extension's Query, so scrapers on the same collector share one PDH handle;
+// otherwise it opens, and on shutdown closes, its own Query.
+type pagingScraper struct {
+	settings receiver.Settings
+
+	query    *perfcounters.Query
+	ownQuery bool
+
+	mu       sync.Mutex
+	pctUsage float64
+}
+
+func newPagingScraper(_ context.Context, settings receiver.Settings, _ *Config) *pagingScraper {
+	return &pagingScraper{settings: settings}
+}
+
+func (s *pagingScraper) start(_ context.Context, host component.Host) error {
+	if q, ok := perfcounters.QueryFromHost(host); ok {
+		s.query = q
+	} else {
+		q, err := perfcounters.NewQuery()
+		if err != nil {
+			return fmt.Errorf("open PDH query: %w", err)
+		}
+		s.query = q
+		s.ownQuery = true
+	}
+
+	return s.query.AddCounter(pctUsageCounter, s)
+}
+
+// Consume implements perfcounters.Consumer. The counter path selects the single
+// "_Total" instance, so values has exactly one entry; its value is used
+// whatever instance name it is keyed by.
+func (s *pagingScraper) Consume(values map[string]float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, v := range values {
+		s.pctUsage = v
+	}
+}
+
+func (s *pagingScraper) shutdown(context.Context) error {
+	if s.ownQuery {
+		return s.query.Close()
+	}
+
+	return nil
+}
+
+func (s *pagingScraper) scrape(context.Context) (pmetric.Metrics, error) {
+	// Collect is safe to call from every scraper sharing this Query: the query
+	// drives a single OS-level PDH collection per call and fans the result out
+	// to every registered Consumer, so this is only wasteful — not incorrect —
+	// when more than one scraper on the shared Query calls it within the same
+	// interval.
+	if err := s.query.Collect(); err != nil {
+		return pmetric.Metrics{}, fmt.Errorf("collect PDH query data: %w", err)
+	}
+
+	s.mu.Lock()
+	pctUsage := s.pctUsage
+	s.mu.Unlock()
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	m := metrics.AppendEmpty()
+	m.SetName("system.paging.utilization")
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	dp.SetDoubleValue(pctUsage / 100)
+
+	return md, nil
+}
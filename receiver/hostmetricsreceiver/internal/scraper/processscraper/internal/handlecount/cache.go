@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlecount // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/handlecount"
+
+import (
+	"sync"
+	"time"
+)
+
+// cache holds per-PID handle counts produced by the most recent Refresh, so that
+// GetProcessHandleCount doesn't need to re-walk the filesystem for every process
+// the scraper asks about within a single collection interval.
+type cache struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	counts    map[int64]uint32
+	expiresAt time.Time
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{ttl: ttl}
+}
+
+// set replaces the cached counts and resets the expiration.
+func (c *cache) set(counts map[int64]uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts = counts
+	c.expiresAt = time.Now().Add(c.ttl)
+}
+
+// get returns the cached count for pid, and whether the cache currently holds a
+// non-expired value for it.
+func (c *cache) get(pid int64) (uint32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts == nil || time.Now().After(c.expiresAt) {
+		return 0, false
+	}
+
+	count, ok := c.counts[pid]
+	return count, ok
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlecount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheGetSet(t *testing.T) {
+	c := newCache(time.Minute)
+
+	_, ok := c.get(1)
+	assert.False(t, ok, "empty cache should have no entries")
+
+	c.set(map[int64]uint32{1: 7, 2: 9})
+
+	v, ok := c.get(1)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(7), v)
+
+	_, ok = c.get(3)
+	assert.False(t, ok, "unknown pid should not be found")
+}
+
+func TestCacheExpires(t *testing.T) {
+	c := newCache(time.Millisecond)
+	c.set(map[int64]uint32{1: 7})
+
+	v, ok := c.get(1)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(7), v)
+
+	time.Sleep(10 * time.Millisecond)
+
+	_, ok = c.get(1)
+	assert.False(t, ok, "cache entries should no longer be served once the ttl has elapsed")
+}
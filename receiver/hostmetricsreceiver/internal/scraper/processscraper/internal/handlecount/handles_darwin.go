@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build darwin
+
+package handlecount // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/handlecount"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/process"
+)
+
+// procManager implements Manager by asking the kernel for each process's open
+// file descriptor table via libproc's proc_pidinfo(PROC_PIDLISTFDS).
+type procManager struct {
+	cache *cache
+}
+
+// NewManager returns a Manager that reads handle counts via libproc. rootPath is
+// accepted for interface parity with the Linux implementation but is unused,
+// since proc_pidinfo is not scoped by filesystem root. ttl should match the
+// receiver's collection interval.
+func NewManager(_ string, ttl time.Duration) Manager {
+	return &procManager{cache: newCache(ttl)}
+}
+
+// Refresh lists the running PIDs and queries the open file descriptor count for
+// each, caching the result.
+func (m *procManager) Refresh() error {
+	pids, err := process.Pids()
+	if err != nil {
+		return fmt.Errorf("list pids: %w", err)
+	}
+
+	counts := make(map[int64]uint32, len(pids))
+	for _, pid := range pids {
+		n, err := fdCount(pid)
+		if err != nil {
+			// The process may have exited since Pids() was called, or we may lack
+			// permission to inspect it; skip it either way.
+			continue
+		}
+		counts[int64(pid)] = n
+	}
+
+	m.cache.set(counts)
+	return nil
+}
+
+// GetProcessHandleCount returns the handle count most recently observed for pid
+// by Refresh.
+func (m *procManager) GetProcessHandleCount(pid int64) (uint32, error) {
+	count, ok := m.cache.get(pid)
+	if !ok {
+		return 0, fmt.Errorf("no cached handle count for pid %d", pid)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package handlecount // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/handlecount"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// procManager implements Manager by counting the entries of /proc/<pid>/fd for
+// each running process.
+type procManager struct {
+	// rootPath is prepended to "/proc", for use when the receiver's view of the
+	// host filesystem is bind-mounted elsewhere (e.g. "/hostfs").
+	rootPath string
+	cache    *cache
+}
+
+// NewManager returns a Manager that reads handle counts from procfs. ttl should
+// match the receiver's collection interval, so that a Refresh at the start of
+// each scrape populates counts that are then served from cache for the rest of
+// that scrape.
+func NewManager(rootPath string, ttl time.Duration) Manager {
+	return &procManager{rootPath: rootPath, cache: newCache(ttl)}
+}
+
+// Refresh walks /proc, counting the entries of each process's fd directory, and
+// caches the result.
+func (m *procManager) Refresh() error {
+	procDir := filepath.Join(m.rootPath, "/proc")
+
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", procDir, err)
+	}
+
+	counts := make(map[int64]uint32, len(entries))
+	for _, entry := range entries {
+		pid, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil {
+			// Not a pid directory (e.g. "self", "net", ...).
+			continue
+		}
+
+		fds, err := os.ReadDir(filepath.Join(procDir, entry.Name(), "fd"))
+		if err != nil {
+			// The process may have exited between the ReadDir above and this one,
+			// or we may lack permission to inspect it; skip it either way.
+			continue
+		}
+
+		counts[pid] = uint32(len(fds))
+	}
+
+	m.cache.set(counts)
+	return nil
+}
+
+// GetProcessHandleCount returns the handle count most recently observed for pid
+// by Refresh.
+func (m *procManager) GetProcessHandleCount(pid int64) (uint32, error) {
+	count, ok := m.cache.get(pid)
+	if !ok {
+		return 0, fmt.Errorf("no cached handle count for pid %d", pid)
+	}
+
+	return count, nil
+}
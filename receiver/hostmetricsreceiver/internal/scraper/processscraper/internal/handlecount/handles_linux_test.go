@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package handlecount
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcManagerRefresh(t *testing.T) {
+	root := t.TempDir()
+
+	writeFakeProcess(t, root, 1, 3)
+	writeFakeProcess(t, root, 2, 0)
+	// Not a pid directory; Refresh should ignore it rather than erroring out.
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "proc", "self"), 0o755))
+
+	m := NewManager(root, time.Minute)
+	require.NoError(t, m.Refresh())
+
+	count, err := m.GetProcessHandleCount(1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), count)
+
+	count, err = m.GetProcessHandleCount(2)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), count)
+
+	_, err = m.GetProcessHandleCount(3)
+	assert.Error(t, err, "pids that were never seen by Refresh should not have a cached count")
+}
+
+func writeFakeProcess(t *testing.T, root string, pid, numFDs int) {
+	t.Helper()
+
+	fdDir := filepath.Join(root, "proc", strconv.Itoa(pid), "fd")
+	require.NoError(t, os.MkdirAll(fdDir, 0o755))
+
+	for i := 0; i < numFDs; i++ {
+		require.NoError(t, os.WriteFile(filepath.Join(fdDir, strconv.Itoa(i)), nil, 0o600))
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build darwin
+
+package handlecount // import "opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/handlecount"
+
+/*
+#include <libproc.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// fdCount returns the number of open file descriptors for pid, via libproc's
+// proc_pidinfo(PROC_PIDLISTFDS). golang.org/x/sys/unix has no binding for this —
+// it's a libSystem/libproc call, not a raw BSD syscall — so this goes through
+// cgo directly, the same reason gopsutil itself doesn't implement NumFDs on
+// darwin without cgo.
+func fdCount(pid int32) (uint32, error) {
+	size := C.proc_pidinfo(C.int(pid), C.PROC_PIDLISTFDS, 0, nil, 0)
+	if size <= 0 {
+		return 0, fmt.Errorf("proc_pidinfo size query for pid %d failed", pid)
+	}
+
+	buf := make([]byte, int(size))
+	n := C.proc_pidinfo(C.int(pid), C.PROC_PIDLISTFDS, 0, unsafe.Pointer(&buf[0]), C.int(len(buf)))
+	if n <= 0 {
+		return 0, fmt.Errorf("proc_pidinfo fd list for pid %d failed", pid)
+	}
+
+	return uint32(n) / uint32(C.sizeof_struct_proc_fdinfo), nil
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver // import "opentelemetry.io/collector/receiver/hostmetricsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal"
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/metadata"
+)
+
+// ScraperFactory is the interface that out-of-tree scrapers must implement to be
+// registered with this receiver via RegisterScraperFactory. It is a re-export of
+// internal.ScraperFactory so that third parties do not need to import the internal
+// package directly.
+type ScraperFactory = internal.ScraperFactory
+
+var (
+	scraperFactoriesMu sync.Mutex
+)
+
+// RegisterScraperFactory registers f under typeStr so that it can be referenced from
+// the "scrapers" section of the receiver configuration. It returns an error if
+// typeStr is already registered, so downstream distributions that embed this
+// receiver can add their own scrapers (GPU, cgroup, eBPF, custom hardware, etc.)
+// without forking the package.
+//
+// The registry is guarded by a mutex, so this may be called concurrently with
+// receiver creation. Receivers take a copy of the registry when they are created,
+// and a factory from NewFactoryWithScrapers takes one when it is built, so a
+// scraper registered afterwards is not available to them.
+func RegisterScraperFactory(typeStr string, f internal.ScraperFactory) error {
+	scraperFactoriesMu.Lock()
+	defer scraperFactoriesMu.Unlock()
+
+	if _, ok := scraperFactories[typeStr]; ok {
+		return fmt.Errorf("scraper factory already registered for type %q", typeStr)
+	}
+
+	scraperFactories[typeStr] = f
+	return nil
+}
+
+// snapshotScraperFactories returns a copy of the package-level scraper factory
+// registry, safe to use without holding scraperFactoriesMu.
+func snapshotScraperFactories() map[string]internal.ScraperFactory {
+	scraperFactoriesMu.Lock()
+	defer scraperFactoriesMu.Unlock()
+
+	return maps.Clone(scraperFactories)
+}
+
+// UnregisterScraperFactory removes the scraper factory registered under typeStr, if
+// any. It exists primarily so that tests can register a fake scraper factory and
+// clean up after themselves.
+func UnregisterScraperFactory(typeStr string) {
+	scraperFactoriesMu.Lock()
+	defer scraperFactoriesMu.Unlock()
+
+	delete(scraperFactories, typeStr)
+}
+
+// ScraperFactoryOption applies an option to the set of scraper factories used by a
+// factory constructed with NewFactoryWithScrapers.
+type ScraperFactoryOption func(map[string]internal.ScraperFactory)
+
+// WithAdditionalScraperFactory returns a ScraperFactoryOption that registers f under
+// typeStr on the factory being built, in addition to the built-in scrapers. It
+// overrides any built-in scraper already registered under the same typeStr, which
+// lets a distribution swap out a built-in scraper if desired.
+func WithAdditionalScraperFactory(typeStr string, f internal.ScraperFactory) ScraperFactoryOption {
+	return func(factories map[string]internal.ScraperFactory) {
+		factories[typeStr] = f
+	}
+}
+
+// NewFactoryWithScrapers creates a new factory for the host metrics receiver whose
+// set of available scrapers is the package-level registry at the time of the call
+// (the built-in scrapers plus any added via RegisterScraperFactory) plus whatever
+// ScraperFactoryOptions are supplied. Unlike RegisterScraperFactory, this does not mutate the package-level
+// scraper registry, so it is safe to use from concurrent tests and does not affect
+// factories created with NewFactory.
+func NewFactoryWithScrapers(options ...ScraperFactoryOption) receiver.Factory {
+	factories := snapshotScraperFactories()
+	for _, opt := range options {
+		opt(factories)
+	}
+
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiverWithFactories(factories), metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+}
+
+// createMetricsReceiverWithFactories returns a receiver.CreateMetricsFunc that builds
+// scrapers from the supplied factories rather than the package-level registry.
+func createMetricsReceiverWithFactories(factories map[string]internal.ScraperFactory) receiver.CreateMetricsFunc {
+	return func(
+		ctx context.Context,
+		set receiver.Settings,
+		cfg component.Config,
+		consumer consumer.Metrics,
+	) (receiver.Metrics, error) {
+		return newMetricsReceiver(ctx, set, cfg, consumer, factories)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal"
+	"opentelemetry.io/collector/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
+)
+
+type fakeScraperFactory struct {
+	internal.ScraperFactory
+}
+
+func TestRegisterScraperFactory(t *testing.T) {
+	const typeStr = "fake"
+	t.Cleanup(func() { UnregisterScraperFactory(typeStr) })
+
+	require.NoError(t, RegisterScraperFactory(typeStr, &fakeScraperFactory{}))
+
+	err := RegisterScraperFactory(typeStr, &fakeScraperFactory{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), typeStr)
+}
+
+func TestRegisterScraperFactory_RejectsBuiltinType(t *testing.T) {
+	err := RegisterScraperFactory(loadscraper.TypeStr, &fakeScraperFactory{})
+	require.Error(t, err)
+}
+
+func TestUnregisterScraperFactory(t *testing.T) {
+	const typeStr = "fake-unregister"
+
+	require.NoError(t, RegisterScraperFactory(typeStr, &fakeScraperFactory{}))
+	UnregisterScraperFactory(typeStr)
+
+	// Registering again after unregistering should succeed.
+	require.NoError(t, RegisterScraperFactory(typeStr, &fakeScraperFactory{}))
+	t.Cleanup(func() { UnregisterScraperFactory(typeStr) })
+}